@@ -5,11 +5,29 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -18,6 +36,101 @@ type ApiConfig struct {
 	BaseURL     string
 	AccessToken string
 	APIKey      string
+
+	// RetryPolicy controls how doRequest retries transient failures. A nil
+	// RetryPolicy means every request is attempted exactly once.
+	RetryPolicy *RetryPolicy
+
+	mu sync.RWMutex
+}
+
+// RetryPolicy configures retry behavior for transient HTTP failures.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+
+	// RetryOn decides whether a given response/error pair should be
+	// retried. Defaults to retrying network errors, 502/503/504, and 429
+	// (honoring Retry-After).
+	RetryOn func(*http.Response, error) bool
+}
+
+// DefaultRetryPolicy returns a conservative retry policy: 3 attempts with
+// exponential backoff starting at 500ms, capped at 10s, with 20% jitter.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// shouldRetry applies RetryOn if set, otherwise the default policy: retry on
+// network errors, 502/503/504, and 429.
+func (p *RetryPolicy) shouldRetry(resp *http.Response, err error) bool {
+	if p.RetryOn != nil {
+		return p.RetryOn(resp, err)
+	}
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextBackoff advances d by the policy's multiplier, adds jitter, and caps
+// the result at MaxBackoff.
+func (p *RetryPolicy) nextBackoff(d time.Duration) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	next := time.Duration(float64(d) * multiplier)
+
+	if p.Jitter > 0 {
+		delta := float64(next) * p.Jitter
+		next += time.Duration(delta*rand.Float64()*2 - delta)
+	}
+
+	if p.MaxBackoff > 0 && next > p.MaxBackoff {
+		next = p.MaxBackoff
+	}
+
+	return next
+}
+
+// retryAfter parses a 429 response's Retry-After header (seconds), if
+// present.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// newIdempotencyKey generates a random UUID-like key, attached once per
+// logical call so the server can deduplicate retried attempts.
+func newIdempotencyKey() string {
+	var b [16]byte
+	_, _ = cryptorand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
 }
 
 // DefaultConfig returns default configuration
@@ -27,10 +140,100 @@ func DefaultConfig() *ApiConfig {
 	}
 }
 
+// setAccessToken atomically swaps the access token so in-flight requests
+// built by doRequest always see a consistent value.
+func (a *ApiConfig) setAccessToken(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.AccessToken = token
+}
+
+// accessToken returns the current access token.
+func (a *ApiConfig) accessToken() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.AccessToken
+}
+
+// setRetryPolicy atomically swaps the retry policy so doRequestCtx, which may
+// run concurrently on the background renewal goroutine for as long as the
+// client is alive, never sees a half-written value.
+func (a *ApiConfig) setRetryPolicy(policy *RetryPolicy) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.RetryPolicy = policy
+}
+
+// retryPolicy returns the current retry policy.
+func (a *ApiConfig) retryPolicy() *RetryPolicy {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.RetryPolicy
+}
+
 // Client is the LLM Orchestrator API client
 type Client struct {
 	config     *ApiConfig
 	httpClient *http.Client
+
+	// watcherMu guards refreshToken, renew, and watcher, all of which are
+	// written from the watcher goroutine on renewal and read/written from
+	// callers via Login/WithRefreshToken/Stop concurrently with it.
+	watcherMu    sync.Mutex
+	refreshToken string
+	renew        func() (*LoginResponse, error)
+	watcher      *lifetimeWatcher
+
+	// OnTokenRenewed, if set, is called after the watcher successfully
+	// refreshes the access token so callers can persist the new tokens.
+	OnTokenRenewed func(*LoginResponse)
+}
+
+// setRefreshToken atomically stores the refresh token used by refresh().
+func (c *Client) setRefreshToken(token string) {
+	c.watcherMu.Lock()
+	defer c.watcherMu.Unlock()
+	c.refreshToken = token
+}
+
+// getRefreshToken atomically reads the refresh token used by refresh().
+func (c *Client) getRefreshToken() string {
+	c.watcherMu.Lock()
+	defer c.watcherMu.Unlock()
+	return c.refreshToken
+}
+
+// setRenew atomically installs the func the watcher calls to renew the
+// access token.
+func (c *Client) setRenew(renew func() (*LoginResponse, error)) {
+	c.watcherMu.Lock()
+	defer c.watcherMu.Unlock()
+	c.renew = renew
+}
+
+// getRenew atomically reads the func the watcher calls to renew the access
+// token.
+func (c *Client) getRenew() func() (*LoginResponse, error) {
+	c.watcherMu.Lock()
+	defer c.watcherMu.Unlock()
+	return c.renew
+}
+
+// swapWatcher atomically installs w as the active watcher and returns the
+// one it replaced, if any.
+func (c *Client) swapWatcher(w *lifetimeWatcher) *lifetimeWatcher {
+	c.watcherMu.Lock()
+	defer c.watcherMu.Unlock()
+	old := c.watcher
+	c.watcher = w
+	return old
+}
+
+// getWatcher atomically reads the active watcher, if any.
+func (c *Client) getWatcher() *lifetimeWatcher {
+	c.watcherMu.Lock()
+	defer c.watcherMu.Unlock()
+	return c.watcher
 }
 
 // NewClient creates a new API client
@@ -43,29 +246,126 @@ func NewClient(config *ApiConfig) *Client {
 	}
 }
 
+// WithRefreshToken builds a client already authenticated with a known access
+// token and refresh token, so long-lived daemons can restart without
+// re-prompting for a password. The background watcher is started
+// immediately, just as it would be after a fresh Login.
+func WithRefreshToken(config *ApiConfig, accessToken, refreshToken string, expiresIn int) *Client {
+	config.setAccessToken(accessToken)
+
+	c := &Client{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		refreshToken: refreshToken,
+	}
+	c.setRenew(c.refresh)
+	c.startWatcher(expiresIn)
+
+	return c
+}
+
+// Stop cancels the background token-renewal watcher, if one is running.
+func (c *Client) Stop() {
+	if w := c.getWatcher(); w != nil {
+		w.stop()
+	}
+}
+
+// WithRetryPolicy attaches a RetryPolicy to the client's config and returns
+// the client so it can be chained after NewClient.
+func (c *Client) WithRetryPolicy(policy *RetryPolicy) *Client {
+	c.config.setRetryPolicy(policy)
+	return c
+}
+
 // doRequest performs an HTTP request with auth headers
 func (c *Client) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	url := c.config.BaseURL + path
+	return c.doRequestCtx(context.Background(), method, path, body)
+}
 
-	var reqBody io.Reader
+// doRequestCtx performs an HTTP request with auth headers, bound to ctx so
+// callers can cancel or set a deadline on a single call. When the client's
+// ApiConfig has a RetryPolicy, transient failures are retried with
+// exponential backoff; otherwise the request is attempted exactly once.
+func (c *Client) doRequestCtx(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var bodyBytes []byte
 	if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		reqBody = bytes.NewBuffer(jsonData)
+		bodyBytes = jsonData
+	}
+
+	var idempotencyKey string
+	if method != http.MethodGet {
+		idempotencyKey = newIdempotencyKey()
+	}
+
+	policy := c.config.retryPolicy()
+	if policy == nil {
+		return c.doRequestOnce(ctx, method, path, bodyBytes, idempotencyKey)
+	}
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	backoff := policy.InitialBackoff
+
+	var resp *http.Response
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		resp, err = c.doRequestOnce(ctx, method, path, bodyBytes, idempotencyKey)
+		if attempt == maxAttempts || !policy.shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait := backoff
+		if after, ok := retryAfter(resp); ok {
+			wait = after
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		backoff = policy.nextBackoff(backoff)
+	}
+
+	return resp, err
+}
+
+// doRequestOnce performs a single HTTP attempt, replaying bodyBytes from a
+// fresh bytes.Reader so it can be sent again across retries.
+func (c *Client) doRequestOnce(ctx context.Context, method, path string, bodyBytes []byte, idempotencyKey string) (*http.Response, error) {
+	url := c.config.BaseURL + path
+
+	var reqBody io.Reader
+	if bodyBytes != nil {
+		reqBody = bytes.NewReader(bodyBytes)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
 
 	// Set authentication headers
-	if c.config.AccessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.config.AccessToken)
+	if token := c.config.accessToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	} else if c.config.APIKey != "" {
 		req.Header.Set("X-API-Key", c.config.APIKey)
 	}
@@ -93,12 +393,113 @@ type LoginResponse struct {
 
 // Login authenticates with username and password
 func (c *Client) Login(username, password string) (*LoginResponse, error) {
+	return c.LoginCtx(context.Background(), username, password)
+}
+
+// LoginCtx authenticates with username and password, bound to ctx.
+func (c *Client) LoginCtx(ctx context.Context, username, password string) (*LoginResponse, error) {
 	req := LoginRequest{
 		Username: username,
 		Password: password,
 	}
 
-	resp, err := c.doRequest("POST", "/auth/login", req)
+	resp, err := c.doRequestCtx(ctx, "POST", "/auth/login", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var loginResp LoginResponse
+	if err := json.NewDecoder(resp.Body).Decode(&loginResp); err != nil {
+		return nil, err
+	}
+
+	// Store access token and keep the refresh token around so the
+	// background watcher can renew it before it expires.
+	c.config.setAccessToken(loginResp.AccessToken)
+	c.setRefreshToken(loginResp.RefreshToken)
+	c.setRenew(c.refresh)
+	c.startWatcher(loginResp.ExpiresIn)
+
+	return &loginResp, nil
+}
+
+// RefreshRequest is the token-refresh request payload
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// refresh exchanges the stored refresh token for a new access token.
+func (c *Client) refresh() (*LoginResponse, error) {
+	req := RefreshRequest{RefreshToken: c.getRefreshToken()}
+
+	resp, err := c.doRequest("POST", "/auth/refresh", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var refreshResp LoginResponse
+	if err := json.NewDecoder(resp.Body).Decode(&refreshResp); err != nil {
+		return nil, err
+	}
+
+	c.config.setAccessToken(refreshResp.AccessToken)
+	if refreshResp.RefreshToken != "" {
+		c.setRefreshToken(refreshResp.RefreshToken)
+	}
+
+	return &refreshResp, nil
+}
+
+// ServiceAccount holds credentials for headless/CI authentication: instead
+// of a username/password, the client signs a short-lived JWT with
+// PrivateKey and exchanges it for an access token.
+type ServiceAccount struct {
+	UID           string
+	PrivateKey    []byte // PEM-encoded RSA or ECDSA private key
+	LoginEndpoint string // defaults to "/auth/service-login"
+}
+
+const serviceAccountTokenTTL = 65 * time.Minute
+
+// serviceLoginRequest is the service-account login request payload.
+type serviceLoginRequest struct {
+	UID   string `json:"uid"`
+	Exp   int64  `json:"exp"`
+	Token string `json:"token"`
+}
+
+// LoginServiceAccount authenticates with a service account's private key
+// instead of a username/password, so daemons can authenticate without
+// storing one. It installs a renew func that re-signs and re-submits the
+// JWT before it expires, since service-account tokens have no refresh_token
+// of their own.
+func (c *Client) LoginServiceAccount(sa *ServiceAccount) (*LoginResponse, error) {
+	return c.LoginServiceAccountCtx(context.Background(), sa)
+}
+
+// LoginServiceAccountCtx authenticates a service account, bound to ctx.
+func (c *Client) LoginServiceAccountCtx(ctx context.Context, sa *ServiceAccount) (*LoginResponse, error) {
+	exp := time.Now().Add(serviceAccountTokenTTL)
+
+	token, err := signServiceAccountJWT(sa.UID, sa.PrivateKey, exp)
+	if err != nil {
+		return nil, fmt.Errorf("sign service account JWT: %w", err)
+	}
+
+	endpoint := sa.LoginEndpoint
+	if endpoint == "" {
+		endpoint = "/auth/service-login"
+	}
+
+	req := serviceLoginRequest{
+		UID:   sa.UID,
+		Exp:   exp.Unix(),
+		Token: token,
+	}
+
+	resp, err := c.doRequestCtx(ctx, "POST", endpoint, req)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +510,192 @@ func (c *Client) Login(username, password string) (*LoginResponse, error) {
 		return nil, err
 	}
 
-	// Store access token
-	c.config.AccessToken = loginResp.AccessToken
+	c.config.setAccessToken(loginResp.AccessToken)
+	c.setRenew(func() (*LoginResponse, error) {
+		return c.LoginServiceAccountCtx(context.Background(), sa)
+	})
+	c.startWatcher(loginResp.ExpiresIn)
 
 	return &loginResp, nil
 }
 
+// signServiceAccountJWT builds and signs a short-lived JWT carrying a uid
+// claim and an exp claim, using RS256 for RSA keys or ES256 for ECDSA keys.
+func signServiceAccountJWT(uid string, privateKeyPEM []byte, exp time.Time) (string, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return "", errors.New("invalid PEM private key")
+	}
+
+	key, err := parsePrivateKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+
+	var alg string
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		alg = "RS256"
+	case *ecdsa.PrivateKey:
+		alg = "ES256"
+	default:
+		return "", errors.New("unsupported private key type")
+	}
+
+	headerSeg, err := jwtSegment(map[string]interface{}{"alg": alg, "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claimsSeg, err := jwtSegment(map[string]interface{}{"uid": uid, "exp": exp.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := headerSeg + "." + claimsSeg
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(cryptorand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		sig, err = signES256(k, digest[:])
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// jwtSegment JSON-encodes v and base64url-encodes it without padding, as
+// required for a JWT header or claims segment.
+func jwtSegment(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// signES256 produces the IEEE P1363 (r||s) signature JWT's ES256 expects,
+// rather than Go's default ASN.1 DER encoding.
+func signES256(key *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(cryptorand.Reader, key, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	size := (key.Curve.Params().BitSize + 7) / 8
+	sig := make([]byte, 2*size)
+	r.FillBytes(sig[:size])
+	s.FillBytes(sig[size:])
+
+	return sig, nil
+}
+
+// parsePrivateKey accepts PKCS#1, PKCS#8, or SEC1 (EC) encoded private keys.
+func parsePrivateKey(der []byte) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key does not support signing")
+	}
+	return signer, nil
+}
+
+// lifetimeWatcher renews a client's access token shortly before it expires,
+// retrying transient failures with exponential backoff until the original
+// expiry is reached.
+type lifetimeWatcher struct {
+	stopCh chan struct{}
+	once   sync.Once
+}
+
+// startWatcher (re)starts the background renewal goroutine for the given
+// token TTL, using whichever renew func the active login flow installed
+// (refresh-token exchange or service-account re-login). Any previously
+// running watcher is stopped first.
+func (c *Client) startWatcher(expiresIn int) {
+	c.Stop()
+	if expiresIn <= 0 || c.getRenew() == nil {
+		return
+	}
+
+	w := &lifetimeWatcher{stopCh: make(chan struct{})}
+	c.swapWatcher(w)
+
+	go c.watch(w, time.Duration(expiresIn)*time.Second)
+}
+
+// watch sleeps until roughly 80% of the token's lifetime has elapsed, then
+// renews it. On failure it retries with exponential backoff, never
+// waiting past the original expiry.
+func (c *Client) watch(w *lifetimeWatcher, ttl time.Duration) {
+	renewAt := time.Duration(float64(ttl) * 0.8)
+
+	select {
+	case <-time.After(renewAt):
+	case <-w.stopCh:
+		return
+	}
+
+	deadline := time.Now().Add(ttl - renewAt)
+	backoff := time.Second
+
+	for {
+		renewed, err := c.getRenew()()
+		if err == nil {
+			if c.OnTokenRenewed != nil {
+				c.OnTokenRenewed(renewed)
+			}
+			select {
+			case <-w.stopCh:
+				return
+			default:
+			}
+			c.startWatcher(renewed.ExpiresIn)
+			return
+		}
+
+		if time.Now().After(deadline) {
+			return
+		}
+
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff)))
+		if remaining := time.Until(deadline); wait > remaining {
+			wait = remaining
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-w.stopCh:
+			return
+		}
+
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// stop signals the watcher goroutine to exit; safe to call multiple times.
+func (w *lifetimeWatcher) stop() {
+	w.once.Do(func() {
+		close(w.stopCh)
+	})
+}
+
 // ==================== Workflows ====================
 
 // Workflow represents a workflow definition
@@ -126,6 +707,7 @@ type Workflow struct {
 	Steps          []map[string]interface{} `json:"steps"`
 	TimeoutSeconds int                    `json:"timeout_seconds,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	Labels         []string               `json:"labels,omitempty"`
 }
 
 // WorkflowList represents a list of workflows
@@ -138,7 +720,12 @@ type WorkflowList struct {
 
 // CreateWorkflow creates a new workflow
 func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
-	resp, err := c.doRequest("POST", "/workflows", workflow)
+	return c.CreateWorkflowCtx(context.Background(), workflow)
+}
+
+// CreateWorkflowCtx creates a new workflow, bound to ctx.
+func (c *Client) CreateWorkflowCtx(ctx context.Context, workflow *Workflow) (*Workflow, error) {
+	resp, err := c.doRequestCtx(ctx, "POST", "/workflows", workflow)
 	if err != nil {
 		return nil, err
 	}
@@ -154,9 +741,14 @@ func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
 
 // ListWorkflows lists all workflows
 func (c *Client) ListWorkflows(limit, offset int) (*WorkflowList, error) {
+	return c.ListWorkflowsCtx(context.Background(), limit, offset)
+}
+
+// ListWorkflowsCtx lists all workflows, bound to ctx.
+func (c *Client) ListWorkflowsCtx(ctx context.Context, limit, offset int) (*WorkflowList, error) {
 	path := fmt.Sprintf("/workflows?limit=%d&offset=%d", limit, offset)
 
-	resp, err := c.doRequest("GET", path, nil)
+	resp, err := c.doRequestCtx(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -172,9 +764,14 @@ func (c *Client) ListWorkflows(limit, offset int) (*WorkflowList, error) {
 
 // GetWorkflow gets workflow details
 func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
+	return c.GetWorkflowCtx(context.Background(), workflowID)
+}
+
+// GetWorkflowCtx gets workflow details, bound to ctx.
+func (c *Client) GetWorkflowCtx(ctx context.Context, workflowID string) (*Workflow, error) {
 	path := fmt.Sprintf("/workflows/%s", workflowID)
 
-	resp, err := c.doRequest("GET", path, nil)
+	resp, err := c.doRequestCtx(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +785,29 @@ func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
 	return &workflow, nil
 }
 
+// UpdateWorkflow replaces an existing workflow's definition
+func (c *Client) UpdateWorkflow(workflowID string, workflow *Workflow) (*Workflow, error) {
+	return c.UpdateWorkflowCtx(context.Background(), workflowID, workflow)
+}
+
+// UpdateWorkflowCtx replaces an existing workflow's definition, bound to ctx.
+func (c *Client) UpdateWorkflowCtx(ctx context.Context, workflowID string, workflow *Workflow) (*Workflow, error) {
+	path := fmt.Sprintf("/workflows/%s", workflowID)
+
+	resp, err := c.doRequestCtx(ctx, "PUT", path, workflow)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updated Workflow
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
 // ==================== Execution ====================
 
 // ExecuteWorkflowRequest is the execution request
@@ -209,6 +829,11 @@ type ExecutionResponse struct {
 
 // ExecuteWorkflow executes a workflow
 func (c *Client) ExecuteWorkflow(workflowID string, inputs map[string]interface{}, async bool) (*ExecutionResponse, error) {
+	return c.ExecuteWorkflowCtx(context.Background(), workflowID, inputs, async)
+}
+
+// ExecuteWorkflowCtx executes a workflow, bound to ctx.
+func (c *Client) ExecuteWorkflowCtx(ctx context.Context, workflowID string, inputs map[string]interface{}, async bool) (*ExecutionResponse, error) {
 	path := fmt.Sprintf("/workflows/%s/execute", workflowID)
 
 	req := ExecuteWorkflowRequest{
@@ -216,7 +841,7 @@ func (c *Client) ExecuteWorkflow(workflowID string, inputs map[string]interface{
 		Async:  async,
 	}
 
-	resp, err := c.doRequest("POST", path, req)
+	resp, err := c.doRequestCtx(ctx, "POST", path, req)
 	if err != nil {
 		return nil, err
 	}
@@ -232,9 +857,14 @@ func (c *Client) ExecuteWorkflow(workflowID string, inputs map[string]interface{
 
 // GetExecutionStatus gets execution status
 func (c *Client) GetExecutionStatus(workflowID, executionID string) (*ExecutionResponse, error) {
+	return c.GetExecutionStatusCtx(context.Background(), workflowID, executionID)
+}
+
+// GetExecutionStatusCtx gets execution status, bound to ctx.
+func (c *Client) GetExecutionStatusCtx(ctx context.Context, workflowID, executionID string) (*ExecutionResponse, error) {
 	path := fmt.Sprintf("/workflows/%s/status?executionId=%s", workflowID, executionID)
 
-	resp, err := c.doRequest("GET", path, nil)
+	resp, err := c.doRequestCtx(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -248,10 +878,126 @@ func (c *Client) GetExecutionStatus(workflowID, executionID string) (*ExecutionR
 	return &status, nil
 }
 
+// ExecutionEvent is a single event frame delivered by StreamExecution.
+type ExecutionEvent struct {
+	Type      string                 `json:"type"`
+	StepID    string                 `json:"step_id,omitempty"`
+	Timestamp string                 `json:"timestamp"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// errStreamUnsupported is returned by StreamExecution when the server has no
+// streaming endpoint for the execution (404), so callers can fall back to
+// polling.
+var errStreamUnsupported = errors.New("orchestrator: execution streaming not supported by server")
+
+// StreamExecution opens a streaming connection for an execution's events and
+// logs, decoding newline-delimited JSON event frames as they arrive. If
+// follow is true, the server replays historical events from the start of the
+// execution before tailing live ones; otherwise only live events are sent.
+// The channel is closed when the server closes the stream, the scanner
+// fails (e.g. a dropped connection), or ctx is cancelled — in all of those
+// cases the channel closes without a terminal "completed"/"failed" event,
+// so callers must not treat a closed channel alone as completion. Callers
+// whose server doesn't support streaming get errStreamUnsupported and
+// should fall back to GetExecutionStatusCtx polling.
+func (c *Client) StreamExecution(ctx context.Context, workflowID, executionID string, follow bool) (<-chan ExecutionEvent, error) {
+	path := fmt.Sprintf("/workflows/%s/executions/%s/stream", workflowID, executionID)
+	if follow {
+		path += "?follow=true"
+	}
+
+	resp, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, errStreamUnsupported
+	}
+	if resp.StatusCode >= 400 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("stream execution: unexpected status %d", resp.StatusCode)
+	}
+
+	events := make(chan ExecutionEvent)
+	go func() {
+		defer resp.Body.Close()
+		defer close(events)
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimPrefix(scanner.Text(), "data: ")
+			if line == "" {
+				continue
+			}
+
+			var event ExecutionEvent
+			if err := json.Unmarshal([]byte(line), &event); err != nil {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
 // WaitForCompletion polls execution status until completion
 func (c *Client) WaitForCompletion(workflowID, executionID string, pollInterval time.Duration, maxAttempts int) (*ExecutionResponse, error) {
+	return c.WaitForCompletionCtx(context.Background(), workflowID, executionID, pollInterval, maxAttempts)
+}
+
+// WaitForCompletionCtx waits for an execution to finish. It prefers
+// streaming via StreamExecution, falling back to polling
+// GetExecutionStatusCtx every pollInterval if the server doesn't support the
+// stream endpoint.
+func (c *Client) WaitForCompletionCtx(ctx context.Context, workflowID, executionID string, pollInterval time.Duration, maxAttempts int) (*ExecutionResponse, error) {
+	events, err := c.StreamExecution(ctx, workflowID, executionID, true)
+	if err == nil {
+		return c.waitForCompletionStream(ctx, workflowID, executionID, events, pollInterval, maxAttempts)
+	}
+	if !errors.Is(err, errStreamUnsupported) {
+		return nil, err
+	}
+
+	return c.waitForCompletionPoll(ctx, workflowID, executionID, pollInterval, maxAttempts)
+}
+
+// waitForCompletionStream consumes execution events until a terminal one
+// arrives, then fetches the final status so the caller gets outputs too. If
+// the channel closes without a terminal event — a dropped connection, a
+// scan error, or any other unexpected close — that is not treated as
+// completion; the wait falls back to polling instead of returning
+// whatever status happens to be current.
+func (c *Client) waitForCompletionStream(ctx context.Context, workflowID, executionID string, events <-chan ExecutionEvent, pollInterval time.Duration, maxAttempts int) (*ExecutionResponse, error) {
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return c.waitForCompletionPoll(ctx, workflowID, executionID, pollInterval, maxAttempts)
+			}
+			if event.Type == "completed" || event.Type == "failed" {
+				return c.GetExecutionStatusCtx(ctx, workflowID, executionID)
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// waitForCompletionPoll selects on ctx.Done() between polls so callers can
+// cancel a long-running wait.
+func (c *Client) waitForCompletionPoll(ctx context.Context, workflowID, executionID string, pollInterval time.Duration, maxAttempts int) (*ExecutionResponse, error) {
 	for i := 0; i < maxAttempts; i++ {
-		status, err := c.GetExecutionStatus(workflowID, executionID)
+		status, err := c.GetExecutionStatusCtx(ctx, workflowID, executionID)
 		if err != nil {
 			return nil, err
 		}
@@ -260,7 +1006,11 @@ func (c *Client) WaitForCompletion(workflowID, executionID string, pollInterval
 			return status, nil
 		}
 
-		time.Sleep(pollInterval)
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 	}
 
 	return nil, fmt.Errorf("workflow execution timeout")
@@ -270,7 +1020,12 @@ func (c *Client) WaitForCompletion(workflowID, executionID string, pollInterval
 
 // HealthCheck performs a health check
 func (c *Client) HealthCheck() (map[string]interface{}, error) {
-	resp, err := c.doRequest("GET", "/health", nil)
+	return c.HealthCheckCtx(context.Background())
+}
+
+// HealthCheckCtx performs a health check, bound to ctx.
+func (c *Client) HealthCheckCtx(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.doRequestCtx(ctx, "GET", "/health", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -284,6 +1039,327 @@ func (c *Client) HealthCheck() (map[string]interface{}, error) {
 	return health, nil
 }
 
+// ==================== Replication ====================
+
+// ReplicationTarget is an orchestrator deployment workflows can be mirrored
+// to, e.g. a staging or prod instance.
+type ReplicationTarget struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	AccessToken string `json:"access_token,omitempty"`
+	APIKey      string `json:"api_key,omitempty"`
+	Insecure    bool   `json:"insecure,omitempty"`
+}
+
+// ReplicationPolicy describes which workflows to mirror, where, and when.
+type ReplicationPolicy struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	// SourceFilter is a glob matched against a source workflow's Name,
+	// Version, or any entry of Labels (see matchesSourceFilter) — a
+	// workflow replicates if any one of those matches.
+	SourceFilter string              `json:"source_filter"`
+	Targets      []ReplicationTarget `json:"targets"`
+	Trigger      string              `json:"trigger"` // "manual", "on_create", or "cron"
+	CronExpr     string              `json:"cron_expr,omitempty"`
+	Enabled      bool                `json:"enabled"`
+}
+
+// ReplicationTargetResult is one target's outcome within a ReplicationRun.
+type ReplicationTargetResult struct {
+	Target    string   `json:"target"`
+	Succeeded int      `json:"succeeded"`
+	Failed    int      `json:"failed"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// ReplicationRun is the result of triggering a ReplicationPolicy. Status is
+// "completed" only if every target replicated cleanly, so CI can fail a
+// deploy on a non-"completed" status.
+type ReplicationRun struct {
+	ID        string                    `json:"id"`
+	PolicyID  string                    `json:"policy_id"`
+	Status    string                    `json:"status"` // "running", "completed", or "failed"
+	Results   []ReplicationTargetResult `json:"results"`
+	StartedAt string                    `json:"started_at,omitempty"`
+	EndedAt   string                    `json:"ended_at,omitempty"`
+}
+
+// CreateReplicationPolicy creates a new replication policy
+func (c *Client) CreateReplicationPolicy(policy *ReplicationPolicy) (*ReplicationPolicy, error) {
+	return c.CreateReplicationPolicyCtx(context.Background(), policy)
+}
+
+// CreateReplicationPolicyCtx creates a new replication policy, bound to ctx.
+func (c *Client) CreateReplicationPolicyCtx(ctx context.Context, policy *ReplicationPolicy) (*ReplicationPolicy, error) {
+	resp, err := c.doRequestCtx(ctx, "POST", "/replication/policies", policy)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var created ReplicationPolicy
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// ListReplicationPolicies lists all replication policies
+func (c *Client) ListReplicationPolicies() ([]ReplicationPolicy, error) {
+	return c.ListReplicationPoliciesCtx(context.Background())
+}
+
+// ListReplicationPoliciesCtx lists all replication policies, bound to ctx.
+func (c *Client) ListReplicationPoliciesCtx(ctx context.Context) ([]ReplicationPolicy, error) {
+	resp, err := c.doRequestCtx(ctx, "GET", "/replication/policies", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var policies []ReplicationPolicy
+	if err := json.NewDecoder(resp.Body).Decode(&policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// GetReplicationPolicy gets a replication policy by ID
+func (c *Client) GetReplicationPolicy(policyID string) (*ReplicationPolicy, error) {
+	return c.GetReplicationPolicyCtx(context.Background(), policyID)
+}
+
+// GetReplicationPolicyCtx gets a replication policy by ID, bound to ctx.
+func (c *Client) GetReplicationPolicyCtx(ctx context.Context, policyID string) (*ReplicationPolicy, error) {
+	path := fmt.Sprintf("/replication/policies/%s", policyID)
+
+	resp, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var policy ReplicationPolicy
+	if err := json.NewDecoder(resp.Body).Decode(&policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetReplicationRun gets a replication run by ID
+func (c *Client) GetReplicationRun(runID string) (*ReplicationRun, error) {
+	return c.GetReplicationRunCtx(context.Background(), runID)
+}
+
+// GetReplicationRunCtx gets a replication run by ID, bound to ctx.
+func (c *Client) GetReplicationRunCtx(ctx context.Context, runID string) (*ReplicationRun, error) {
+	path := fmt.Sprintf("/replication/runs/%s", runID)
+
+	resp, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var run ReplicationRun
+	if err := json.NewDecoder(resp.Body).Decode(&run); err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// replicationPageSize is the page size used when paging through
+// ListWorkflowsCtx to build the full source/target workflow sets.
+const replicationPageSize = 100
+
+// TriggerReplication mirrors the workflows matched by policyID's
+// SourceFilter from this client (the source) to each of the policy's
+// targets.
+func (c *Client) TriggerReplication(policyID string) (*ReplicationRun, error) {
+	return c.TriggerReplicationCtx(context.Background(), policyID)
+}
+
+// TriggerReplicationCtx mirrors workflows as TriggerReplication does, bound
+// to ctx. For every target it lists existing workflows, diffs them against
+// the source by a (name, version) content fingerprint, and upserts only the
+// workflows that are missing or changed, then persists the resulting
+// per-target success/failure counts as a ReplicationRun. A target that
+// can't be reached is recorded as a failed result rather than aborting the
+// other targets.
+func (c *Client) TriggerReplicationCtx(ctx context.Context, policyID string) (*ReplicationRun, error) {
+	policy, err := c.GetReplicationPolicyCtx(ctx, policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := c.listAllWorkflowsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]Workflow, 0, len(source))
+	for _, wf := range source {
+		ok, err := matchesSourceFilter(policy.SourceFilter, wf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source filter %q: %w", policy.SourceFilter, err)
+		}
+		if ok {
+			matched = append(matched, wf)
+		}
+	}
+
+	run := &ReplicationRun{PolicyID: policyID, Status: "completed"}
+	for _, target := range policy.Targets {
+		result := c.replicateToTarget(ctx, target, matched)
+		if result.Failed > 0 {
+			run.Status = "failed"
+		}
+		run.Results = append(run.Results, *result)
+	}
+
+	resp, err := c.doRequestCtx(ctx, "POST", "/replication/runs", run)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var persisted ReplicationRun
+	if err := json.NewDecoder(resp.Body).Decode(&persisted); err != nil {
+		return nil, err
+	}
+
+	return &persisted, nil
+}
+
+// listAllWorkflowsCtx pages through ListWorkflowsCtx until every workflow
+// has been read, so replication never silently drops workflows past the
+// first page.
+func (c *Client) listAllWorkflowsCtx(ctx context.Context) ([]Workflow, error) {
+	var all []Workflow
+	for offset := 0; ; offset += replicationPageSize {
+		page, err := c.ListWorkflowsCtx(ctx, replicationPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Workflows...)
+		if len(page.Workflows) == 0 || len(all) >= page.Total {
+			return all, nil
+		}
+	}
+}
+
+// matchesSourceFilter reports whether wf matches a glob pattern where "*"
+// matches any run of characters, including "/" (workflow names, versions,
+// and labels are logical identifiers, not filesystem paths). The pattern is
+// tried against wf.Name, wf.Version, and each entry of wf.Labels; wf matches
+// if any one of those matches. An empty pattern matches everything.
+func matchesSourceFilter(pattern string, wf Workflow) (bool, error) {
+	if pattern == "" {
+		return true, nil
+	}
+
+	escaped := regexp.QuoteMeta(pattern)
+	escaped = strings.ReplaceAll(escaped, `\*`, ".*")
+
+	re, err := regexp.Compile("^" + escaped + "$")
+	if err != nil {
+		return false, err
+	}
+
+	if re.MatchString(wf.Name) || re.MatchString(wf.Version) {
+		return true, nil
+	}
+	for _, label := range wf.Labels {
+		if re.MatchString(label) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// replicateToTarget upserts matched workflows into a single target,
+// skipping any whose (name, version) fingerprint already matches. Errors
+// reaching the target are reported as a failed result rather than
+// propagated, so one unreachable target doesn't abort replication to the
+// others.
+func (c *Client) replicateToTarget(ctx context.Context, target ReplicationTarget, matched []Workflow) *ReplicationTargetResult {
+	result := &ReplicationTargetResult{Target: target.Name}
+
+	targetClient := newReplicationTargetClient(target)
+
+	existing, err := targetClient.listAllWorkflowsCtx(ctx)
+	if err != nil {
+		result.Failed = len(matched)
+		result.Errors = append(result.Errors, fmt.Sprintf("list workflows on target %s: %v", target.Name, err))
+		return result
+	}
+
+	existingByKey := make(map[string]Workflow, len(existing))
+	for _, wf := range existing {
+		existingByKey[wf.Name+"@"+wf.Version] = wf
+	}
+
+	for _, wf := range matched {
+		old, present := existingByKey[wf.Name+"@"+wf.Version]
+
+		var err error
+		switch {
+		case present && workflowFingerprint(old) == workflowFingerprint(wf):
+			continue
+		case present:
+			_, err = targetClient.UpdateWorkflowCtx(ctx, old.ID, &wf)
+		default:
+			_, err = targetClient.CreateWorkflowCtx(ctx, &wf)
+		}
+
+		if err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s@%s: %v", wf.Name, wf.Version, err))
+			continue
+		}
+		result.Succeeded++
+	}
+
+	return result
+}
+
+// newReplicationTargetClient builds a throwaway Client for talking to a
+// single replication target.
+func newReplicationTargetClient(target ReplicationTarget) *Client {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	if target.Insecure {
+		httpClient.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+
+	return &Client{
+		config: &ApiConfig{
+			BaseURL:     target.URL,
+			AccessToken: target.AccessToken,
+			APIKey:      target.APIKey,
+		},
+		httpClient: httpClient,
+	}
+}
+
+// workflowFingerprint hashes the parts of a workflow that define its
+// behavior (not its server-assigned ID), so replication can tell whether a
+// target's copy is stale.
+func workflowFingerprint(wf Workflow) string {
+	wf.ID = ""
+	data, _ := json.Marshal(wf)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // ==================== Example Usage ====================
 
 func main() {